@@ -0,0 +1,36 @@
+package client
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// nextBackoff returns the delay before reconnect attempt number attempt
+// (0-indexed), applying the policy's exponential growth capped at
+// MaxInterval plus up to 20% jitter so that many clients reconnecting at
+// once don't thunder the herd.
+func (p ReconnectPolicy) nextBackoff(attempt int) time.Duration {
+	interval := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(attempt))
+	if p.MaxInterval > 0 && interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+
+	jitter := interval * 0.2 * rand.Float64()
+
+	return time.Duration(interval + jitter)
+}
+
+// exhausted reports whether the policy forbids any further reconnect
+// attempts given the attempt count so far and the time elapsed since the
+// first connection attempt.
+func (p ReconnectPolicy) exhausted(attempt int, elapsed time.Duration) bool {
+	if p.MaxRetries > 0 && attempt >= p.MaxRetries {
+		return true
+	}
+	if p.MaxElapsedTime > 0 && elapsed >= p.MaxElapsedTime {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconnectPolicyExhausted(t *testing.T) {
+	policy := ReconnectPolicy{MaxRetries: 3, MaxElapsedTime: time.Minute}
+
+	cases := []struct {
+		attempt int
+		elapsed time.Duration
+		want    bool
+	}{
+		{attempt: 0, elapsed: 0, want: false},
+		{attempt: 2, elapsed: 30 * time.Second, want: false},
+		{attempt: 3, elapsed: 30 * time.Second, want: true},
+		{attempt: 0, elapsed: time.Minute, want: true},
+	}
+
+	for _, c := range cases {
+		if got := policy.exhausted(c.attempt, c.elapsed); got != c.want {
+			t.Errorf("exhausted(%d, %s) = %v, want %v", c.attempt, c.elapsed, got, c.want)
+		}
+	}
+}
+
+func TestReconnectPolicyExhaustedUnbounded(t *testing.T) {
+	var policy ReconnectPolicy
+	if policy.exhausted(1000, 365*24*time.Hour) {
+		t.Error("exhausted() with zero MaxRetries/MaxElapsedTime should never report exhausted")
+	}
+}
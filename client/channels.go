@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Ticks streams tradeable pricing ticks onto a channel instead of a
+// callback, so callers can compose feeds with select, merge multiple
+// Clients, or feed a strategy framework directly. If more instruments are
+// configured (via WithInstruments or a long currencies list) than fit in
+// a single pricing stream, Ticks opens one connection per batch and fans
+// their results into the single returned channel.
+//
+// Both channels are closed once every underlying connection has stopped,
+// which happens when ctx is canceled. The error channel is buffered and
+// reports at most one error per connection; later errors on the same
+// connection are dropped once that slot is filled.
+func (c *Client) Ticks(ctx context.Context) (<-chan *Tick, <-chan error) {
+	ticks := make(chan *Tick)
+
+	if err := c.requirePricingClient(); err != nil {
+		errs := make(chan error, 1)
+		errs <- err
+		close(ticks)
+		close(errs)
+		return ticks, errs
+	}
+
+	groups, err := c.instrumentGroups()
+	if err != nil {
+		errs := make(chan error, 1)
+		errs <- err
+		close(ticks)
+		close(errs)
+		return ticks, errs
+	}
+
+	errs := make(chan error, len(groups))
+
+	var wg sync.WaitGroup
+	for _, group := range groups {
+		group := group
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := c.runStream(
+				ctx,
+				func(ctx context.Context) (*http.Request, error) {
+					return http.NewRequestWithContext(ctx, "GET", c.pricingURL(group), nil)
+				},
+				func(line []byte) (bool, error) {
+					value, err := (PricingDecoder{}).Decode(line)
+					if err != nil {
+						c.metricsOrNoop().IncUnmarshalErrors()
+						return false, err
+					}
+
+					if _, isHeartbeat := value.(*Heartbeat); isHeartbeat {
+						return true, nil
+					}
+
+					tick := value.(*Tick)
+					if tick.IsTradeable() {
+						c.metricsOrNoop().IncTicks(tick.Instrument)
+						if parsedTime, err := tick.parseTime(); err == nil {
+							c.metricsOrNoop().ObserveLatency(time.Since(parsedTime))
+						}
+						select {
+						case ticks <- tick:
+						case <-ctx.Done():
+							return false, ctx.Err()
+						}
+					}
+
+					return false, nil
+				},
+			)
+			if err != nil && ctx.Err() == nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(ticks)
+		close(errs)
+	}()
+
+	return ticks, errs
+}
+
+// Transactions streams every transaction (including non-fill events) onto
+// a channel instead of a callback, so callers can filter or correlate them
+// however their strategy needs rather than being limited to the
+// fill-and-close filtering RunTransactions applies. Like RunTransactions,
+// it resumes from the last transaction ID seen across reconnects.
+//
+// Both channels are closed once the stream stops, which happens when ctx
+// is canceled.
+func (c *Client) Transactions(ctx context.Context) (<-chan *Transaction, <-chan error) {
+	transactions := make(chan *Transaction)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(transactions)
+		defer close(errs)
+
+		err := c.runStream(
+			ctx,
+			func(ctx context.Context) (*http.Request, error) {
+				url := fmt.Sprintf(c.baseUrl, c.account)
+
+				c.mu.Lock()
+				lastID := c.lastTransactionID
+				c.mu.Unlock()
+				if lastID != "" {
+					url = fmt.Sprintf("%s?from=%s", url, nextTransactionID(lastID))
+				}
+
+				return http.NewRequestWithContext(ctx, "GET", url, nil)
+			},
+			func(line []byte) (bool, error) {
+				transaction := &Transaction{}
+				if err := json.Unmarshal(line, transaction); err != nil {
+					c.metricsOrNoop().IncUnmarshalErrors()
+					return false, fmt.Errorf("json.Unmarshal: %w", err)
+				}
+
+				if transaction.Id != "" {
+					c.mu.Lock()
+					c.lastTransactionID = transaction.Id
+					c.mu.Unlock()
+				}
+
+				select {
+				case transactions <- transaction:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+
+				return transaction.IsHeartbeat(), nil
+			},
+		)
+		if err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return transactions, errs
+}
+
+// TypedTransactions is like Transactions but decodes each message with
+// c.decoder (or NewTransactionDecoder's defaults, if none was set via
+// WithTransactionDecoder), sending strongly typed values such as
+// *OrderFillTransaction or *Heartbeat rather than the generic
+// *Transaction. Callers type-switch on the channel's interface{} values.
+func (c *Client) TypedTransactions(ctx context.Context) (<-chan interface{}, <-chan error) {
+	decoder := c.decoder
+	if decoder == nil {
+		decoder = NewTransactionDecoder()
+	}
+
+	transactions := make(chan interface{})
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(transactions)
+		defer close(errs)
+
+		err := c.runStream(
+			ctx,
+			func(ctx context.Context) (*http.Request, error) {
+				url := fmt.Sprintf(c.baseUrl, c.account)
+
+				c.mu.Lock()
+				lastID := c.lastTransactionID
+				c.mu.Unlock()
+				if lastID != "" {
+					url = fmt.Sprintf("%s?from=%s", url, nextTransactionID(lastID))
+				}
+
+				return http.NewRequestWithContext(ctx, "GET", url, nil)
+			},
+			func(line []byte) (bool, error) {
+				value, err := decoder.Decode(line)
+				if err != nil {
+					c.metricsOrNoop().IncUnmarshalErrors()
+					return false, err
+				}
+
+				if identifiable, ok := value.(interface{ TransactionID() string }); ok {
+					if id := identifiable.TransactionID(); id != "" {
+						c.mu.Lock()
+						c.lastTransactionID = id
+						c.mu.Unlock()
+					}
+				}
+
+				_, isHeartbeat := value.(*Heartbeat)
+
+				select {
+				case transactions <- value:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+
+				return isHeartbeat, nil
+			},
+		)
+		if err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return transactions, errs
+}
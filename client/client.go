@@ -1,18 +1,16 @@
 package client
 
 import (
-	"bufio"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-var baseUrl string
-
 // {
 // 	"time": "2016-12-20T05:55:46.064294036Z",
 // 	"type": "HEARTBEAT"
@@ -97,6 +95,18 @@ func (t *Transaction) IsOrderFill() bool {
 	return "ORDER_FILL" == t.Type
 }
 
+func (t *Transaction) IsHeartbeat() bool {
+	return "HEARTBEAT" == t.Type
+}
+
+// TransactionID returns t.Id. It exists so that types embedding
+// Transaction (see TypedTransactions' registered types) can be tracked
+// for stream resume via a common interface without a type switch per
+// concrete type.
+func (t *Transaction) TransactionID() string {
+	return t.Id
+}
+
 func (t *Transaction) IsMarketOrderTradeClose() bool {
 	return "MARKET_ORDER_TRADE_CLOSE" == t.Reason
 
@@ -155,22 +165,25 @@ func (t *Tick) Nanoseconds() (int64, error) {
 	return int64(parsedTime.Nanosecond()), nil
 }
 
-func (t *Tick) BestAsk() (float64, error) {
+// BestAsk returns the lowest ask as a fixed-point Price, preserving the
+// precision OANDA sent rather than rounding through float64.
+func (t *Tick) BestAsk() (Price, error) {
 	if 0 == len(t.Asks) {
-		return 0.0, nil
+		return Price{}, nil
 	}
 
-	var best float64
+	best, err := t.Asks[0].PriceDecimal()
+	if err != nil {
+		return Price{}, err
+	}
 
 	// best ask is the lowest
-	for _, ask := range t.Asks {
-		val, err := ask.PriceAsFloat()
+	for _, ask := range t.Asks[1:] {
+		val, err := ask.PriceDecimal()
 		if err != nil {
-			return 0.0, err
+			return Price{}, err
 		}
-		if 0 == best {
-			best = val
-		} else if val < best {
+		if val.LessThan(best) {
 			best = val
 		}
 	}
@@ -178,20 +191,25 @@ func (t *Tick) BestAsk() (float64, error) {
 	return best, nil
 }
 
-func (t *Tick) BestBid() (float64, error) {
+// BestBid returns the highest bid as a fixed-point Price, preserving the
+// precision OANDA sent rather than rounding through float64.
+func (t *Tick) BestBid() (Price, error) {
 	if 0 == len(t.Bids) {
-		return 0.0, nil
+		return Price{}, nil
 	}
 
-	var best float64
+	best, err := t.Bids[0].PriceDecimal()
+	if err != nil {
+		return Price{}, err
+	}
 
 	// best bid is the highest
-	for _, bid := range t.Bids {
-		val, err := bid.PriceAsFloat()
+	for _, bid := range t.Bids[1:] {
+		val, err := bid.PriceDecimal()
 		if err != nil {
-			return 0.0, err
+			return Price{}, err
 		}
-		if val > best {
+		if val.GreaterThan(best) {
 			best = val
 		}
 	}
@@ -204,6 +222,8 @@ type Quote struct {
 	Price     string `json:"price"`
 }
 
+// PriceAsFloat parses Price through float64. Kept for callers that only
+// need an approximate value; prefer PriceDecimal for P&L math.
 func (q *Quote) PriceAsFloat() (float64, error) {
 	val, err := strconv.ParseFloat(q.Price, 64)
 	if err != nil {
@@ -213,117 +233,202 @@ func (q *Quote) PriceAsFloat() (float64, error) {
 	return val, nil
 }
 
+// PriceDecimal parses Price as a fixed-point Price, preserving every
+// digit OANDA sent.
+func (q *Quote) PriceDecimal() (Price, error) {
+	return ParsePrice(q.Price)
+}
+
 type Client struct {
 	account    string
 	token      string
 	currencies string
 	client_type      string
+	baseUrl    string
+
+	instruments []string
+	decoder     *TransactionDecoder
+
+	loggerImpl Logger
+	debug      bool
+	metrics    Metrics
+
+	reconnectPolicy  ReconnectPolicy
+	heartbeatTimeout time.Duration
+
+	mu                sync.Mutex
+	lastTransactionID string
 }
 
-func New(account, token, currencies string, live bool) *Client {
-	if live {
-		baseUrl = "https://stream-fxtrade.oanda.com/v3/accounts/%s/pricing/stream?instruments=%s"
-	} else {
-		baseUrl = "https://stream-fxpractice.oanda.com/v3/accounts/%s/pricing/stream?instruments=%s"
-	}
-	return &Client{
+func New(account, token, currencies string, live bool, opts ...ClientOption) *Client {
+	c := &Client{
 		account:    account,
 		token:      token,
 		currencies: currencies,
 		client_type:	   "PRICE",
 	}
-}
-
-func NewTransaction(account, token string, live bool) *Client {
 	if live {
-		baseUrl = "https://api-fxtrade.oanda.com/v3/accounts/%s/transactions/stream"
+		c.baseUrl = "https://stream-fxtrade.oanda.com/v3/accounts/%s/pricing/stream?instruments=%s"
 	} else {
-		baseUrl = "https://api-fxpractice.oanda.com/v3/accounts/%s/transactions/stream"
+		c.baseUrl = "https://stream-fxpractice.oanda.com/v3/accounts/%s/pricing/stream?instruments=%s"
 	}
-	return &Client{
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func NewTransaction(account, token string, live bool, opts ...ClientOption) *Client {
+	c := &Client{
 		account:    account,
 		token:      token,
 		currencies: "",
 		client_type:	   "TRANSACTION",
 	}
+	if live {
+		c.baseUrl = "https://api-fxtrade.oanda.com/v3/accounts/%s/transactions/stream"
+	} else {
+		c.baseUrl = "https://api-fxpractice.oanda.com/v3/accounts/%s/transactions/stream"
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-func (c *Client) url() string {
-	return fmt.Sprintf(baseUrl, c.account, c.currencies)
-}
-
-
+// url builds the pricing stream URL for every instrument configured via
+// WithInstruments, falling back to c.currencies if none were set. Unlike
+// Ticks, RunContext opens a single connection, so if the combined
+// instrument list exceeds OANDA's per-stream cap, use Ticks instead,
+// which transparently splits across connections.
+func (c *Client) url() (string, error) {
+	if err := c.requirePricingClient(); err != nil {
+		return "", err
+	}
 
-func (c *Client) Run(f func(*Tick)) error {
-	req, err := http.NewRequest("GET", c.url(), nil)
+	groups, err := c.instrumentGroups()
 	if err != nil {
-		return errors.New(fmt.Sprint("http.NewRequest:", err))
+		return "", err
 	}
 
-	// set our bearer token
-	req.Header.Set("Authorization", "Bearer "+c.token)
-
-	// just use the DefaultClient, no need to be fancy here
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return errors.New(fmt.Sprint("http.Get:", err))
+	var instruments []string
+	for _, group := range groups {
+		instruments = append(instruments, group...)
 	}
 
-	tick := &Tick{}
+	return fmt.Sprintf(c.baseUrl, c.account, strings.Join(instruments, ",")), nil
+}
 
-	reader := bufio.NewReader(resp.Body)
-	for {
-		line, err := reader.ReadBytes('\n')
-		if err != nil {
-			// technically, we should never get io.EOF here
-			return errors.New(fmt.Sprint("reader.ReadBytes:", err))
-		}
 
-		if err := json.Unmarshal(line, tick); err != nil {
-			return errors.New(fmt.Sprint("json.Unmarshal:", err))
-		}
 
-		// skip a few kinds of ticks here:
-		//   - the heartbeat which is sent every 5 seconds
-		//   - the "last prices" sent when initially connecting to the API
-		if tick.IsTradeable() {
-			f(tick)
-		}
-	}
+// Run streams pricing ticks, invoking f for each tradeable tick. It
+// reconnects automatically according to c.reconnectPolicy (see
+// WithReconnect) and only returns once the policy is exhausted or on an
+// error that reconnecting cannot fix (a 4xx response, or a request that
+// cannot be built). It is a thin wrapper around RunContext using
+// context.Background().
+func (c *Client) Run(f func(*Tick)) error {
+	return c.RunContext(context.Background(), f)
 }
 
+// RunContext is like Run but accepts a context.Context: canceling ctx
+// stops the stream, closes the underlying response body, and RunContext
+// returns ctx.Err().
+func (c *Client) RunContext(ctx context.Context, f func(*Tick)) error {
+	return c.runStream(
+		ctx,
+		func(ctx context.Context) (*http.Request, error) {
+			url, err := c.url()
+			if err != nil {
+				return nil, err
+			}
+			return http.NewRequestWithContext(ctx, "GET", url, nil)
+		},
+		func(line []byte) (bool, error) {
+			value, err := (PricingDecoder{}).Decode(line)
+			if err != nil {
+				c.metricsOrNoop().IncUnmarshalErrors()
+				return false, err
+			}
+
+			if _, isHeartbeat := value.(*Heartbeat); isHeartbeat {
+				return true, nil
+			}
+
+			// skip a few kinds of ticks here:
+			//   - the "last prices" sent when initially connecting to the API
+			tick := value.(*Tick)
+			if tick.IsTradeable() {
+				c.metricsOrNoop().IncTicks(tick.Instrument)
+				if parsedTime, err := tick.parseTime(); err == nil {
+					c.metricsOrNoop().ObserveLatency(time.Since(parsedTime))
+				}
+				f(tick)
+			}
+
+			return false, nil
+		},
+	)
+}
+
+// RunTransactions streams account transactions, invoking f for order fills
+// that close or take profit on a trade. Like Run, it reconnects
+// automatically; on reconnect it resumes from the last transaction ID it
+// saw via the stream's ?from= parameter so fills aren't missed across a
+// disconnect. It is a thin wrapper around RunTransactionsContext using
+// context.Background().
 func (c *Client) RunTransactions(f func(*Transaction)) error {
-	url := fmt.Sprintf(baseUrl, c.account)
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return errors.New(fmt.Sprintf("http.NewRequest: url: %s, error: %v", url, err))
-	}
+	return c.RunTransactionsContext(context.Background(), f)
+}
 
-	// set our bearer token
-	req.Header.Set("Authorization", "Bearer "+c.token)
+// RunTransactionsContext is like RunTransactions but accepts a
+// context.Context: canceling ctx stops the stream, closes the underlying
+// response body, and RunTransactionsContext returns ctx.Err().
+func (c *Client) RunTransactionsContext(ctx context.Context, f func(*Transaction)) error {
+	return c.runStream(
+		ctx,
+		func(ctx context.Context) (*http.Request, error) {
+			url := fmt.Sprintf(c.baseUrl, c.account)
+
+			c.mu.Lock()
+			lastID := c.lastTransactionID
+			c.mu.Unlock()
+			if lastID != "" {
+				url = fmt.Sprintf("%s?from=%s", url, nextTransactionID(lastID))
+			}
+
+			return http.NewRequestWithContext(ctx, "GET", url, nil)
+		},
+		func(line []byte) (bool, error) {
+			transaction := &Transaction{}
+			if err := json.Unmarshal(line, transaction); err != nil {
+				c.metricsOrNoop().IncUnmarshalErrors()
+				return false, fmt.Errorf("json.Unmarshal: %w", err)
+			}
+
+			if transaction.Id != "" {
+				c.mu.Lock()
+				c.lastTransactionID = transaction.Id
+				c.mu.Unlock()
+			}
+
+			if transaction.IsOrderFill() && (transaction.IsMarketOrderTradeClose() || transaction.IsTakeProfitOrder()) {
+				f(transaction)
+			}
+
+			return transaction.IsHeartbeat(), nil
+		},
+	)
+}
 
-	// just use the DefaultClient, no need to be fancy here
-	resp, err := http.DefaultClient.Do(req)
+// nextTransactionID returns id+1 for use as the ?from= resume point. If id
+// isn't numeric (shouldn't happen for OANDA transaction IDs), it is
+// returned unchanged so the stream simply resumes from the same point.
+func nextTransactionID(id string) string {
+	n, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return errors.New(fmt.Sprint("http.Get:", err))
+		return id
 	}
 
-	transaction := &Transaction{}
-
-	reader := bufio.NewReader(resp.Body)
-	for {
-		line, err := reader.ReadBytes('\n')
-		if err != nil {
-			// technically, we should never get io.EOF here
-			return errors.New(fmt.Sprint("reader.ReadBytes:", err))
-		}
-
-		if err := json.Unmarshal(line, transaction); err != nil {
-			return errors.New(fmt.Sprint("json.Unmarshal:", err))
-		}
-
-		if transaction.IsOrderFill() && (transaction.IsMarketOrderTradeClose() || transaction.IsTakeProfitOrder()) {
-			f(transaction)
-		}
-	}
+	return strconv.FormatInt(n+1, 10)
 }
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Decoder turns a raw stream line into a typed value, typically by
+// peeking at the message's "type" field before deciding which concrete
+// struct to unmarshal into.
+type Decoder interface {
+	Decode(line []byte) (interface{}, error)
+}
+
+// envelope is the "type" discriminator shared by every message on both
+// streams, used for the peek pass before dispatching to a concrete type.
+type envelope struct {
+	Type string `json:"type"`
+}
+
+// TransactionDecoder dispatches transaction stream lines to strongly
+// typed structs based on their "type" field - e.g. ORDER_FILL decodes to
+// *OrderFillTransaction rather than the generic *Transaction. Types
+// without a registered factory fall back to *Transaction, so unrecognized
+// or future transaction types still decode rather than erroring.
+type TransactionDecoder struct {
+	registry map[string]func() interface{}
+}
+
+// NewTransactionDecoder returns a TransactionDecoder pre-registered with
+// the transaction types this package knows about.
+func NewTransactionDecoder() *TransactionDecoder {
+	d := &TransactionDecoder{registry: map[string]func() interface{}{}}
+	d.Register("HEARTBEAT", func() interface{} { return &Heartbeat{} })
+	d.Register("ORDER_FILL", func() interface{} { return &OrderFillTransaction{} })
+	d.Register("MARKET_ORDER", func() interface{} { return &MarketOrderTransaction{} })
+	d.Register("TAKE_PROFIT_ORDER", func() interface{} { return &TakeProfitOrderTransaction{} })
+	return d
+}
+
+// Register adds or overrides the concrete type constructed for a given
+// transaction "type" value, letting callers decode custom or
+// undocumented transaction types without forking the package.
+func (d *TransactionDecoder) Register(transactionType string, newValue func() interface{}) {
+	d.registry[transactionType] = newValue
+}
+
+// Decode peeks at line's "type" field, allocates a fresh value of the
+// registered concrete type (or *Transaction if none is registered), and
+// unmarshals line into it.
+func (d *TransactionDecoder) Decode(line []byte) (interface{}, error) {
+	var env envelope
+	if err := json.Unmarshal(line, &env); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal (peek): %w", err)
+	}
+
+	newValue, ok := d.registry[env.Type]
+	if !ok {
+		newValue = func() interface{} { return &Transaction{} }
+	}
+
+	value := newValue()
+	if err := json.Unmarshal(line, value); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return value, nil
+}
+
+// PricingDecoder dispatches pricing stream lines to *Heartbeat for
+// HEARTBEAT messages and *Tick for everything else (PRICE and the
+// initial "last prices" messages OANDA sends on connect). Unlike
+// TransactionDecoder it has no registry: the pricing stream's message
+// shapes are fixed by OANDA's API rather than open-ended like transaction
+// types, so there's nothing for a caller to register.
+type PricingDecoder struct{}
+
+// Decode peeks at line's "type" field, allocates a fresh *Heartbeat or
+// *Tick, and unmarshals line into it.
+func (PricingDecoder) Decode(line []byte) (interface{}, error) {
+	var env envelope
+	if err := json.Unmarshal(line, &env); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal (peek): %w", err)
+	}
+
+	var value interface{}
+	if env.Type == "HEARTBEAT" {
+		value = &Heartbeat{}
+	} else {
+		value = &Tick{}
+	}
+
+	if err := json.Unmarshal(line, value); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return value, nil
+}
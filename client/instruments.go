@@ -0,0 +1,86 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// defaultMaxInstrumentsPerStream bounds how many instruments are requested
+// on a single pricing stream connection. OANDA enforces a per-stream
+// instrument cap; once the configured instruments exceed it, Ticks opens
+// one connection per batch and merges them into a single channel.
+const defaultMaxInstrumentsPerStream = 20
+
+var instrumentPattern = regexp.MustCompile(`^[A-Z]{3}_[A-Z]{3}$`)
+
+// ValidateInstrument reports whether s is a well-formed OANDA instrument
+// name, e.g. "EUR_USD".
+func ValidateInstrument(s string) error {
+	if !instrumentPattern.MatchString(s) {
+		return fmt.Errorf("client: invalid instrument %q, want format XXX_YYY", s)
+	}
+
+	return nil
+}
+
+// WithInstruments sets the instruments to stream as a []string instead of
+// a comma-separated currencies string, validating each against the
+// XXX_YYY format. Ticks transparently splits more instruments than fit in
+// a single connection (see defaultMaxInstrumentsPerStream) across
+// multiple connections and merges their results.
+func WithInstruments(instruments []string) ClientOption {
+	return func(c *Client) {
+		c.instruments = append([]string(nil), instruments...)
+	}
+}
+
+// requirePricingClient returns an error if c wasn't built with New. Ticks,
+// RunContext, and pricingURL format c.baseUrl with two verbs (account,
+// then instruments), while NewTransaction's baseUrl only has one; calling
+// a pricing method on a transaction client would otherwise silently embed
+// a stray "%!(EXTRA string=...)" in the request URL instead of erroring.
+func (c *Client) requirePricingClient() error {
+	if c.client_type != "PRICE" {
+		return fmt.Errorf("client: this method requires a client built with New, not NewTransaction")
+	}
+
+	return nil
+}
+
+// instrumentGroups returns the instruments to stream, validated and split
+// into batches of at most defaultMaxInstrumentsPerStream.
+func (c *Client) instrumentGroups() ([][]string, error) {
+	instruments := c.instruments
+	if len(instruments) == 0 && c.currencies != "" {
+		instruments = strings.Split(c.currencies, ",")
+	}
+
+	for _, instrument := range instruments {
+		if err := ValidateInstrument(instrument); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(instruments) == 0 {
+		return [][]string{{}}, nil
+	}
+
+	var groups [][]string
+	for len(instruments) > 0 {
+		n := defaultMaxInstrumentsPerStream
+		if n > len(instruments) {
+			n = len(instruments)
+		}
+		groups = append(groups, instruments[:n])
+		instruments = instruments[n:]
+	}
+
+	return groups, nil
+}
+
+// pricingURL builds the pricing stream URL for a single batch of
+// instruments.
+func (c *Client) pricingURL(instruments []string) string {
+	return fmt.Sprintf(c.baseUrl, c.account, strings.Join(instruments, ","))
+}
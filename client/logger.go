@@ -0,0 +1,56 @@
+package client
+
+import "log"
+
+// Logger receives debug and error messages from the read loop: reconnect
+// attempts, heartbeat watchdog trips, and (with WithDebug) raw stream
+// lines. The zero value Client uses a no-op Logger, so logging is opt-in.
+//
+// Both log/slog and zap already fit this shape: *zap.SugaredLogger
+// implements Logger directly (its Debugf/Errorf signatures match), and
+// NewSlogLogger adapts a *slog.Logger.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Debugf(string, ...interface{}) {}
+func (noopLogger) Errorf(string, ...interface{}) {}
+
+// stdLogger is the fallback used when WithDebug(true) is set without an
+// explicit WithLogger, so debug output has somewhere to go.
+type stdLogger struct{}
+
+func (stdLogger) Debugf(format string, args ...interface{}) { log.Printf("[DEBUG] "+format, args...) }
+func (stdLogger) Errorf(format string, args ...interface{}) { log.Printf("[ERROR] "+format, args...) }
+
+// WithLogger wires l into the read loop's instrumentation points; see
+// Logger.
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) {
+		c.loggerImpl = l
+	}
+}
+
+// WithDebug dumps every raw line read off the stream via the configured
+// Logger's Debugf (or a standard-library log.Printf fallback if no
+// Logger was set).
+func WithDebug(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.debug = enabled
+	}
+}
+
+// log returns the effective Logger: the one set via WithLogger, or
+// stdLogger if debug mode is on without one, or a no-op otherwise.
+func (c *Client) log() Logger {
+	if c.loggerImpl != nil {
+		return c.loggerImpl
+	}
+	if c.debug {
+		return stdLogger{}
+	}
+	return noopLogger{}
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics receives counters and histogram observations from the read
+// loop. Each method maps directly onto a Prometheus counter/histogram
+// Inc/Observe call, so a Metrics implementation typically just wraps a
+// prometheus.CounterVec/HistogramVec. The zero value Client uses a no-op
+// Metrics.
+type Metrics interface {
+	// IncTicks counts one tradeable tick received for instrument.
+	IncTicks(instrument string)
+	// IncReconnects counts one reconnect attempt after a dropped stream.
+	IncReconnects()
+	// IncUnmarshalErrors counts one message that failed to decode.
+	IncUnmarshalErrors()
+	// ObserveHeartbeatInterval records the time between two consecutive
+	// HEARTBEAT messages.
+	ObserveHeartbeatInterval(d time.Duration)
+	// ObserveLatency records end-to-end latency: time.Now() minus the
+	// tick's own timestamp.
+	ObserveLatency(d time.Duration)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) IncTicks(string)                        {}
+func (noopMetrics) IncReconnects()                         {}
+func (noopMetrics) IncUnmarshalErrors()                    {}
+func (noopMetrics) ObserveHeartbeatInterval(time.Duration) {}
+func (noopMetrics) ObserveLatency(time.Duration)           {}
+
+// WithMetrics wires m into the read loop's instrumentation points; see
+// Metrics.
+func WithMetrics(m Metrics) ClientOption {
+	return func(c *Client) {
+		c.metrics = m
+	}
+}
+
+// metricsOrNoop returns the effective Metrics: the one set via
+// WithMetrics, or a no-op otherwise.
+func (c *Client) metricsOrNoop() Metrics {
+	if c.metrics != nil {
+		return c.metrics
+	}
+	return noopMetrics{}
+}
+
+// SimpleMetrics is a dependency-free Metrics implementation that keeps
+// running counters and observation slices in memory, for callers who
+// want basic visibility without wiring up Prometheus. Safe for
+// concurrent use.
+type SimpleMetrics struct {
+	ticks           sync.Map // instrument (string) -> *int64
+	reconnects      int64
+	unmarshalErrors int64
+
+	mu                 sync.Mutex
+	heartbeatIntervals []time.Duration
+	latencies          []time.Duration
+}
+
+// NewSimpleMetrics returns an empty SimpleMetrics.
+func NewSimpleMetrics() *SimpleMetrics {
+	return &SimpleMetrics{}
+}
+
+func (m *SimpleMetrics) IncTicks(instrument string) {
+	v, _ := m.ticks.LoadOrStore(instrument, new(int64))
+	atomic.AddInt64(v.(*int64), 1)
+}
+
+func (m *SimpleMetrics) IncReconnects() {
+	atomic.AddInt64(&m.reconnects, 1)
+}
+
+func (m *SimpleMetrics) IncUnmarshalErrors() {
+	atomic.AddInt64(&m.unmarshalErrors, 1)
+}
+
+func (m *SimpleMetrics) ObserveHeartbeatInterval(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.heartbeatIntervals = append(m.heartbeatIntervals, d)
+}
+
+func (m *SimpleMetrics) ObserveLatency(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies = append(m.latencies, d)
+}
+
+// TickCount returns the number of tradeable ticks observed for
+// instrument so far.
+func (m *SimpleMetrics) TickCount(instrument string) int64 {
+	v, ok := m.ticks.Load(instrument)
+	if !ok {
+		return 0
+	}
+	return atomic.LoadInt64(v.(*int64))
+}
+
+// ReconnectCount returns the number of reconnect attempts so far.
+func (m *SimpleMetrics) ReconnectCount() int64 {
+	return atomic.LoadInt64(&m.reconnects)
+}
+
+// UnmarshalErrorCount returns the number of messages that failed to
+// decode so far.
+func (m *SimpleMetrics) UnmarshalErrorCount() int64 {
+	return atomic.LoadInt64(&m.unmarshalErrors)
+}
+
+// HeartbeatIntervals returns a copy of every heartbeat interval observed
+// so far.
+func (m *SimpleMetrics) HeartbeatIntervals() []time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]time.Duration(nil), m.heartbeatIntervals...)
+}
+
+// Latencies returns a copy of every end-to-end latency observed so far.
+func (m *SimpleMetrics) Latencies() []time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]time.Duration(nil), m.latencies...)
+}
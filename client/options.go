@@ -0,0 +1,54 @@
+package client
+
+import "time"
+
+// ClientOption configures optional behavior on a Client, following the
+// functional-options pattern.
+type ClientOption func(*Client)
+
+// ReconnectPolicy controls how a Client re-establishes a dropped stream
+// connection: exponential backoff with jitter between attempts, bounded
+// by MaxRetries and MaxElapsedTime (zero means unbounded).
+type ReconnectPolicy struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	MaxRetries      int
+	MaxElapsedTime  time.Duration
+}
+
+// DefaultReconnectPolicy starts backing off at 1s, doubles up to a 30s
+// cap, and retries indefinitely.
+func DefaultReconnectPolicy() ReconnectPolicy {
+	return ReconnectPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+	}
+}
+
+// WithReconnect overrides the default policy used to reconnect when the
+// underlying stream connection drops.
+func WithReconnect(policy ReconnectPolicy) ClientOption {
+	return func(c *Client) {
+		c.reconnectPolicy = policy
+	}
+}
+
+// WithTransactionDecoder overrides the TransactionDecoder used by
+// TypedTransactions, e.g. to register additional transaction types via
+// TransactionDecoder.Register before passing it in.
+func WithTransactionDecoder(d *TransactionDecoder) ClientOption {
+	return func(c *Client) {
+		c.decoder = d
+	}
+}
+
+// WithHeartbeatTimeout forces a reconnect if no HEARTBEAT is seen within
+// d. OANDA sends one every 5s, so 10-15s is a reasonable timeout. Zero
+// (the default) disables the watchdog.
+func WithHeartbeatTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.heartbeatTimeout = d
+	}
+}
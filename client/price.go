@@ -0,0 +1,190 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Price is a fixed-point decimal: mantissa holds the digits and scale is
+// the number of digits after the decimal point. It parses OANDA's price
+// strings exactly, unlike strconv.ParseFloat, which rounds FX pips to the
+// nearest representable float64 and makes P&L math unreliable over many
+// accumulated ticks.
+//
+// The mantissa is an int64, so ParsePrice (and the Transaction *Decimal
+// accessors built on it) fails on a value whose digits, with the decimal
+// point removed, don't fit in 64 bits - up to 19 significant digits, e.g.
+// "92233720368.54775". This comfortably covers every price, pip, and
+// realistic account balance OANDA sends; a caller expecting wider values
+// should parse the string field directly rather than through Price.
+type Price struct {
+	mantissa int64
+	scale    uint8
+}
+
+// ParsePrice parses a decimal string such as "117.680" or "-12.3" into a
+// Price, preserving every digit OANDA sent rather than rounding through
+// float64.
+func ParsePrice(s string) (Price, error) {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return Price{}, fmt.Errorf("client: empty price")
+	}
+
+	negative := strings.HasPrefix(trimmed, "-")
+	if negative {
+		trimmed = trimmed[1:]
+	}
+
+	parts := strings.SplitN(trimmed, ".", 2)
+	intPart := parts[0]
+	fracPart := ""
+	if len(parts) == 2 {
+		fracPart = parts[1]
+	}
+
+	mantissa, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return Price{}, fmt.Errorf("client: invalid price %q: %w", s, err)
+	}
+	if negative {
+		mantissa = -mantissa
+	}
+
+	return Price{mantissa: mantissa, scale: uint8(len(fracPart))}, nil
+}
+
+func pow10(n uint8) int64 {
+	result := int64(1)
+	for i := uint8(0); i < n; i++ {
+		result *= 10
+	}
+	return result
+}
+
+// rescale returns p expressed with the given number of decimal digits,
+// truncating extra precision if scale is smaller than p.scale.
+func (p Price) rescale(scale uint8) Price {
+	switch {
+	case scale == p.scale:
+		return p
+	case scale > p.scale:
+		return Price{mantissa: p.mantissa * pow10(scale-p.scale), scale: scale}
+	default:
+		return Price{mantissa: p.mantissa / pow10(p.scale-scale), scale: scale}
+	}
+}
+
+// String renders p back into the decimal form OANDA sent, e.g. "117.680".
+func (p Price) String() string {
+	if p.scale == 0 {
+		return strconv.FormatInt(p.mantissa, 10)
+	}
+
+	negative := p.mantissa < 0
+	m := p.mantissa
+	if negative {
+		m = -m
+	}
+
+	digits := strconv.FormatInt(m, 10)
+	for len(digits) <= int(p.scale) {
+		digits = "0" + digits
+	}
+
+	whole, frac := digits[:len(digits)-int(p.scale)], digits[len(digits)-int(p.scale):]
+
+	out := whole + "." + frac
+	if negative {
+		out = "-" + out
+	}
+	return out
+}
+
+// Float64 returns p as a float64, for display or math where rounding is
+// acceptable.
+func (p Price) Float64() float64 {
+	f, _ := strconv.ParseFloat(p.String(), 64)
+	return f
+}
+
+// Add returns p+other, rescaled to whichever operand has more precision.
+func (p Price) Add(other Price) Price {
+	scale := p.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	a, b := p.rescale(scale), other.rescale(scale)
+	return Price{mantissa: a.mantissa + b.mantissa, scale: scale}
+}
+
+// Sub returns p-other, rescaled to whichever operand has more precision.
+func (p Price) Sub(other Price) Price {
+	scale := p.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	a, b := p.rescale(scale), other.rescale(scale)
+	return Price{mantissa: a.mantissa - b.mantissa, scale: scale}
+}
+
+// Compare returns -1, 0, or 1 as p is less than, equal to, or greater
+// than other.
+func (p Price) Compare(other Price) int {
+	scale := p.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	a, b := p.rescale(scale).mantissa, other.rescale(scale).mantissa
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (p Price) LessThan(other Price) bool    { return p.Compare(other) < 0 }
+func (p Price) GreaterThan(other Price) bool { return p.Compare(other) > 0 }
+
+// PipSize returns the size of one pip for an instrument, following FX
+// convention: 0.01 for JPY-quoted pairs, 0.0001 otherwise. Pass
+// Tick.IsJapanese() for isJapanese.
+func PipSize(isJapanese bool) Price {
+	if isJapanese {
+		return Price{mantissa: 1, scale: 2}
+	}
+	return Price{mantissa: 1, scale: 4}
+}
+
+// Pips converts p (typically the result of Price.Sub between two quotes)
+// into a pip count for the given instrument convention.
+func (p Price) Pips(isJapanese bool) float64 {
+	pip := PipSize(isJapanese)
+	scale := p.scale
+	if pip.scale > scale {
+		scale = pip.scale
+	}
+	return float64(p.rescale(scale).mantissa) / float64(pip.rescale(scale).mantissa)
+}
+
+// AddPips returns p shifted by n pips (n may be negative or fractional)
+// for the given instrument convention.
+func (p Price) AddPips(n float64, isJapanese bool) Price {
+	pip := PipSize(isJapanese)
+	shift := Price{mantissa: int64(n * float64(pow10(pip.scale))), scale: pip.scale}
+	return p.Add(shift)
+}
+
+// FormatPrice renders p to the display precision OANDA uses for
+// instrument: 3 decimal places for JPY pairs, 5 otherwise.
+func (p Price) FormatPrice(instrument string) string {
+	scale := uint8(5)
+	if strings.Contains(instrument, "JPY") {
+		scale = 3
+	}
+	return p.rescale(scale).String()
+}
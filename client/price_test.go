@@ -0,0 +1,82 @@
+package client
+
+import "testing"
+
+func TestParsePrice(t *testing.T) {
+	price, err := ParsePrice("117.680")
+	if err != nil {
+		t.Fatalf("ParsePrice: %v", err)
+	}
+	if got, want := price.String(), "117.680"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	negative, err := ParsePrice("-12.3")
+	if err != nil {
+		t.Fatalf("ParsePrice: %v", err)
+	}
+	if got, want := negative.String(), "-12.3"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+// TestParsePriceHighPrecisionBalance locks in the documented mantissa
+// limit: a value whose digits (decimal point removed) still fit in an
+// int64 parses exactly, even at AccountBalance-style magnitude and
+// precision.
+func TestParsePriceHighPrecisionBalance(t *testing.T) {
+	const balance = "92233720368.54775"
+	price, err := ParsePrice(balance)
+	if err != nil {
+		t.Fatalf("ParsePrice(%q): %v", balance, err)
+	}
+	if got := price.String(); got != balance {
+		t.Errorf("String() = %q, want %q", got, balance)
+	}
+}
+
+func TestParsePriceOverflow(t *testing.T) {
+	if _, err := ParsePrice("922337203685.4775808"); err == nil {
+		t.Fatal("ParsePrice: want error for a mantissa too wide for int64, got nil")
+	}
+}
+
+func TestPriceAdd(t *testing.T) {
+	a, _ := ParsePrice("117.68")
+	b, _ := ParsePrice("0.002")
+	if got, want := a.Add(b).String(), "117.682"; got != want {
+		t.Errorf("Add() = %q, want %q", got, want)
+	}
+}
+
+func TestPriceSub(t *testing.T) {
+	a, _ := ParsePrice("117.682")
+	b, _ := ParsePrice("117.665")
+	if got, want := a.Sub(b).String(), "0.017"; got != want {
+		t.Errorf("Sub() = %q, want %q", got, want)
+	}
+}
+
+func TestPriceRescale(t *testing.T) {
+	p, _ := ParsePrice("117.68")
+	if got, want := p.rescale(4).String(), "117.6800"; got != want {
+		t.Errorf("rescale(4) = %q, want %q", got, want)
+	}
+	if got, want := p.rescale(1).String(), "117.6"; got != want {
+		t.Errorf("rescale(1) (truncating) = %q, want %q", got, want)
+	}
+}
+
+func TestPricePips(t *testing.T) {
+	ask, _ := ParsePrice("117.682")
+	bid, _ := ParsePrice("117.665")
+	if got, want := ask.Sub(bid).Pips(true), 1.7; got != want {
+		t.Errorf("Pips(JPY) = %v, want %v", got, want)
+	}
+
+	eurAsk, _ := ParsePrice("1.23456")
+	eurBid, _ := ParsePrice("1.23446")
+	if got, want := eurAsk.Sub(eurBid).Pips(false), 1.0; got != want {
+		t.Errorf("Pips(non-JPY) = %v, want %v", got, want)
+	}
+}
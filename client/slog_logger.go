@@ -0,0 +1,24 @@
+package client
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// SlogLogger adapts a *slog.Logger to the Logger interface.
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger backed by l.
+func NewSlogLogger(l *slog.Logger) *SlogLogger {
+	return &SlogLogger{logger: l}
+}
+
+func (s *SlogLogger) Debugf(format string, args ...interface{}) {
+	s.logger.Debug(fmt.Sprintf(format, args...))
+}
+
+func (s *SlogLogger) Errorf(format string, args ...interface{}) {
+	s.logger.Error(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// permanentError wraps an error that reconnecting cannot fix (e.g. a 4xx
+// response for a bad token or account), so runStream surfaces it to the
+// caller immediately instead of retrying under the reconnect policy.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// runStream drives newRequest/handleLine against the stream, transparently
+// reconnecting with c.reconnectPolicy whenever the connection drops, until
+// ctx is canceled, a permanentError occurs, or the policy is exhausted. An
+// error from handleLine is retried like a dropped connection. Each reconnect is counted via Metrics.IncReconnects and
+// logged via Logger.Errorf. attempt and start reset whenever a connection
+// delivers at least one message before dropping, so the policy's
+// MaxRetries/MaxElapsedTime bound consecutive failures rather than a
+// long-running client's lifetime drop count.
+func (c *Client) runStream(ctx context.Context, newRequest func(context.Context) (*http.Request, error), handleLine func([]byte) (bool, error)) error {
+	policy := c.reconnectPolicy
+	if (policy == ReconnectPolicy{}) {
+		policy = DefaultReconnectPolicy()
+	}
+
+	start := time.Now()
+	attempt := 0
+	var lastHeartbeat time.Time
+	for {
+		delivered, err := c.connectAndRead(ctx, newRequest, handleLine, &lastHeartbeat)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var permErr *permanentError
+		if errors.As(err, &permErr) {
+			return permErr.err
+		}
+		if delivered {
+			attempt = 0
+			start = time.Now()
+		}
+		if policy.exhausted(attempt, time.Since(start)) {
+			return fmt.Errorf("oanda stream: giving up after %d attempts: %w", attempt+1, err)
+		}
+
+		c.metricsOrNoop().IncReconnects()
+		c.log().Errorf("oanda stream: reconnecting after error (attempt %d): %v", attempt+1, err)
+
+		time.Sleep(policy.nextBackoff(attempt))
+		attempt++
+	}
+}
+
+// connectAndRead opens a single connection and streams lines to handleLine
+// until the connection errors, handleLine errors, ctx is canceled, or
+// (when c.heartbeatTimeout is set) no HEARTBEAT arrives within the
+// timeout. lastHeartbeat persists across reconnects so
+// Metrics.ObserveHeartbeatInterval reflects the gap across a dropped
+// connection too. The returned bool reports whether at least one message
+// was successfully handled before the connection ended, so runStream can
+// reset its backoff/attempt counters instead of ratcheting them across
+// unrelated, independent drops.
+func (c *Client) connectAndRead(ctx context.Context, newRequest func(context.Context) (*http.Request, error), handleLine func([]byte) (bool, error), lastHeartbeat *time.Time) (delivered bool, err error) {
+	req, err := newRequest(ctx)
+	if err != nil {
+		return false, &permanentError{fmt.Errorf("http.NewRequest: %w", err)}
+	}
+
+	// set our bearer token
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("http.Get: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return false, &permanentError{fmt.Errorf("oanda stream: %s returned %s: %s", req.URL, resp.Status, bytes.TrimSpace(body))}
+	}
+
+	// readCtx is canceled on every return from connectAndRead (watchdog
+	// trip, handleLine error, ctx cancellation, ...), not just ctx itself,
+	// so the reader goroutine's pending send below always has a way out -
+	// otherwise it would leak blocked on `lines <- line` past the point
+	// where nothing will ever receive from it.
+	readCtx, cancelRead := context.WithCancel(ctx)
+	defer cancelRead()
+
+	// lines is buffered so the reader goroutine can hand one line off and
+	// go back to ReadBytes without blocking on every single message.
+	lines := make(chan []byte, 1)
+	readErrs := make(chan error, 1)
+
+	go func() {
+		reader := bufio.NewReader(resp.Body)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if err != nil {
+				// technically, we should never get io.EOF here
+				readErrs <- fmt.Errorf("reader.ReadBytes: %w", err)
+				return
+			}
+			select {
+			case lines <- line:
+			case <-readCtx.Done():
+				return
+			}
+		}
+	}()
+
+	var watchdog *time.Timer
+	var watchdogC <-chan time.Time
+	if c.heartbeatTimeout > 0 {
+		watchdog = time.NewTimer(c.heartbeatTimeout)
+		defer watchdog.Stop()
+		watchdogC = watchdog.C
+	}
+
+	for {
+		select {
+		case line := <-lines:
+			if c.debug {
+				c.log().Debugf("recv: %s", bytes.TrimSpace(line))
+			}
+
+			isHeartbeat, err := handleLine(line)
+			if err != nil {
+				return delivered, err
+			}
+			delivered = true
+
+			if isHeartbeat {
+				now := time.Now()
+				if !lastHeartbeat.IsZero() {
+					c.metricsOrNoop().ObserveHeartbeatInterval(now.Sub(*lastHeartbeat))
+				}
+				*lastHeartbeat = now
+
+				if watchdog != nil {
+					if !watchdog.Stop() {
+						<-watchdog.C
+					}
+					watchdog.Reset(c.heartbeatTimeout)
+				}
+			}
+		case err := <-readErrs:
+			return delivered, err
+		case <-watchdogC:
+			return delivered, fmt.Errorf("oanda stream: no heartbeat within %s", c.heartbeatTimeout)
+		case <-ctx.Done():
+			return delivered, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package client
+
+// The accessors below parse Transaction's numeric string fields into
+// fixed-point Price values, preserving precision that strconv.ParseFloat
+// would lose. The underlying string fields are left untouched so the
+// struct still round-trips through JSON exactly as OANDA sent it.
+
+func (t *Transaction) UnitsDecimal() (Price, error) {
+	return ParsePrice(t.Units)
+}
+
+func (t *Transaction) PriceDecimal() (Price, error) {
+	return ParsePrice(t.Price)
+}
+
+func (t *Transaction) FullVWAPDecimal() (Price, error) {
+	return ParsePrice(t.FullVWAP)
+}
+
+func (t *Transaction) FullPriceDecimal() (Price, error) {
+	return ParsePrice(t.FullPrice)
+}
+
+func (t *Transaction) PlDecimal() (Price, error) {
+	return ParsePrice(t.Pl)
+}
+
+func (t *Transaction) QuotePlDecimal() (Price, error) {
+	return ParsePrice(t.QuotePl)
+}
+
+func (t *Transaction) FinancingDecimal() (Price, error) {
+	return ParsePrice(t.Financing)
+}
+
+func (t *Transaction) BaseFinancingDecimal() (Price, error) {
+	return ParsePrice(t.BaseFinancing)
+}
+
+func (t *Transaction) QuoteFinancingDecimal() (Price, error) {
+	return ParsePrice(t.QuoteFinancing)
+}
+
+func (t *Transaction) CommissionDecimal() (Price, error) {
+	return ParsePrice(t.Commission)
+}
+
+func (t *Transaction) GuaranteedExecutionFeeDecimal() (Price, error) {
+	return ParsePrice(t.GuaranteedExecutionFee)
+}
+
+func (t *Transaction) QuoteGuaranteedExecutionFeeDecimal() (Price, error) {
+	return ParsePrice(t.QuoteGuaranteedExecutionFee)
+}
+
+func (t *Transaction) AccountBalanceDecimal() (Price, error) {
+	return ParsePrice(t.AccountBalance)
+}
+
+func (t *Transaction) HalfSpreadCostDecimal() (Price, error) {
+	return ParsePrice(t.HalfSpreadCost)
+}
@@ -0,0 +1,33 @@
+package client
+
+import "time"
+
+// Heartbeat is the keep-alive message sent periodically on both the
+// pricing and transactions streams.
+type Heartbeat struct {
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+}
+
+// OrderFillTransaction represents an ORDER_FILL transaction: an order was
+// filled, opening, closing, or reducing a trade.
+type OrderFillTransaction struct {
+	Transaction
+}
+
+// MarketOrderTransaction represents a MARKET_ORDER transaction: a market
+// order was created, typically in response to a client request or as the
+// result of a trade close.
+type MarketOrderTransaction struct {
+	Transaction
+	TimeInForce  string `json:"timeInForce"`
+	PositionFill string `json:"positionFill"`
+}
+
+// TakeProfitOrderTransaction represents a TAKE_PROFIT_ORDER transaction: a
+// take-profit order was created against an open trade.
+type TakeProfitOrderTransaction struct {
+	Transaction
+	TradeID     string `json:"tradeID"`
+	TimeInForce string `json:"timeInForce"`
+}
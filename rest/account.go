@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// AccountSummary mirrors OANDA's v3 account summary representation, as
+// returned by GetAccountSummary.
+type AccountSummary struct {
+	Id                string `json:"id"`
+	Alias             string `json:"alias"`
+	Currency          string `json:"currency"`
+	Balance           string `json:"balance"`
+	OpenTradeCount    int    `json:"openTradeCount"`
+	OpenPositionCount int    `json:"openPositionCount"`
+	PendingOrderCount int    `json:"pendingOrderCount"`
+	NAV               string `json:"NAV"`
+	UnrealizedPL      string `json:"unrealizedPL"`
+	MarginUsed        string `json:"marginUsed"`
+	MarginAvailable   string `json:"marginAvailable"`
+}
+
+// AccountSummaryResponse is returned by GetAccountSummary.
+type AccountSummaryResponse struct {
+	Account           AccountSummary `json:"account"`
+	LastTransactionID string         `json:"lastTransactionID"`
+}
+
+// GetAccountSummary returns a summary of the account's current balance,
+// margin, and position/order counts.
+func (c *Client) GetAccountSummary() (*AccountSummaryResponse, error) {
+	var out AccountSummaryResponse
+	path := fmt.Sprintf("/v3/accounts/%s/summary", c.account)
+	if err := c.do(http.MethodGet, path, nil, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,50 @@
+package rest
+
+// TimeInForce mirrors OANDA's v3 order timeInForce values.
+type TimeInForce string
+
+const (
+	GTC TimeInForce = "GTC" // good 'til canceled
+	GTD TimeInForce = "GTD" // good 'til a gtdTime
+	IOC TimeInForce = "IOC" // immediate or cancel
+	FOK TimeInForce = "FOK" // fill or kill
+)
+
+// OrderOption configures an order placed via PlaceMarketOrder or
+// PlaceLimitOrder, following the same functional-options pattern as
+// client.ClientOption.
+type OrderOption func(*orderOptions)
+
+type orderOptions struct {
+	timeInForce TimeInForce
+	postOnly    bool
+}
+
+func newOrderOptions(opts []OrderOption) orderOptions {
+	var o orderOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithTimeInForce overrides an order's default time-in-force (FOK for
+// market orders, GTC for limit orders).
+func WithTimeInForce(tif TimeInForce) OrderOption {
+	return func(o *orderOptions) {
+		o.timeInForce = tif
+	}
+}
+
+// WithPostOnly requests a maker-only limit order. This is intentionally
+// unsupported: OANDA's v3 REST API has no post-only order type and no
+// field that rejects a LIMIT order for crossing the spread, so there is
+// no way to honor it without either silently placing a marketable order
+// or polling afterward to cancel a filled order - both worse than
+// failing up front. PlaceLimitOrder rejects the request with an error
+// before making any API call.
+func WithPostOnly() OrderOption {
+	return func(o *orderOptions) {
+		o.postOnly = true
+	}
+}
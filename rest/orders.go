@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/habuka036/go-oanda-streaming-api/client"
+)
+
+// OrderResponse is returned by order placement and cancellation calls.
+// The embedded transactions are client.Transaction, the same type
+// RunTransactions surfaces, so a strategy can correlate an order it just
+// placed with the ORDER_FILL it later sees on the stream via
+// OrderFillTransaction.OrderId / .Id.
+type OrderResponse struct {
+	OrderCreateTransaction *client.Transaction `json:"orderCreateTransaction,omitempty"`
+	OrderFillTransaction   *client.Transaction `json:"orderFillTransaction,omitempty"`
+	OrderCancelTransaction *client.Transaction `json:"orderCancelTransaction,omitempty"`
+	RelatedTransactionIDs  []string            `json:"relatedTransactionIDs"`
+	LastTransactionID      string              `json:"lastTransactionID"`
+}
+
+type marketOrderRequest struct {
+	Order marketOrderFields `json:"order"`
+}
+
+type marketOrderFields struct {
+	Type         string      `json:"type"`
+	Instrument   string      `json:"instrument"`
+	Units        string      `json:"units"`
+	TimeInForce  TimeInForce `json:"timeInForce"`
+	PositionFill string      `json:"positionFill"`
+}
+
+// PlaceMarketOrder places a MARKET order for units of instrument (units
+// may be negative to sell/short). Defaults to FOK unless overridden with
+// WithTimeInForce.
+func (c *Client) PlaceMarketOrder(instrument, units string, opts ...OrderOption) (*OrderResponse, error) {
+	options := newOrderOptions(opts)
+	if options.postOnly {
+		return nil, fmt.Errorf("rest: post-only does not apply to market orders")
+	}
+
+	tif := options.timeInForce
+	if tif == "" {
+		tif = FOK
+	}
+
+	body := marketOrderRequest{Order: marketOrderFields{
+		Type:         "MARKET",
+		Instrument:   instrument,
+		Units:        units,
+		TimeInForce:  tif,
+		PositionFill: "DEFAULT",
+	}}
+
+	var out OrderResponse
+	path := fmt.Sprintf("/v3/accounts/%s/orders", c.account)
+	if err := c.do(http.MethodPost, path, body, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+type limitOrderRequest struct {
+	Order limitOrderFields `json:"order"`
+}
+
+type limitOrderFields struct {
+	Type         string      `json:"type"`
+	Instrument   string      `json:"instrument"`
+	Units        string      `json:"units"`
+	Price        string      `json:"price"`
+	TimeInForce  TimeInForce `json:"timeInForce"`
+	PositionFill string      `json:"positionFill"`
+}
+
+// PlaceLimitOrder places a LIMIT order for units of instrument at price
+// (a decimal string, e.g. "1.23456"). Defaults to GTC unless overridden
+// with WithTimeInForce. WithPostOnly is rejected; see its doc comment.
+func (c *Client) PlaceLimitOrder(instrument, units, price string, opts ...OrderOption) (*OrderResponse, error) {
+	options := newOrderOptions(opts)
+	if options.postOnly {
+		return nil, fmt.Errorf("rest: OANDA's v3 API has no native post-only order type")
+	}
+
+	tif := options.timeInForce
+	if tif == "" {
+		tif = GTC
+	}
+
+	body := limitOrderRequest{Order: limitOrderFields{
+		Type:         "LIMIT",
+		Instrument:   instrument,
+		Units:        units,
+		Price:        price,
+		TimeInForce:  tif,
+		PositionFill: "DEFAULT",
+	}}
+
+	var out OrderResponse
+	path := fmt.Sprintf("/v3/accounts/%s/orders", c.account)
+	if err := c.do(http.MethodPost, path, body, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// CancelOrder cancels the pending order identified by orderID.
+func (c *Client) CancelOrder(orderID string) (*OrderResponse, error) {
+	var out OrderResponse
+	path := fmt.Sprintf("/v3/accounts/%s/orders/%s/cancel", c.account, orderID)
+	if err := c.do(http.MethodPut, path, nil, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,85 @@
+// Package rest is a sibling to the client (streaming) package: it covers
+// OANDA v3 REST endpoints to place and cancel orders, close positions, and
+// read open trades and the account summary; it has no call for modifying
+// an existing order. A strategy can act on a fill it sees via client.Client.RunTransactions
+// without reaching for a second library. It shares the same auth (token)
+// and base-URL selection (practice vs live) convention as client.Client.
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Client is a REST client for a single OANDA account.
+type Client struct {
+	account string
+	token   string
+	live    bool
+
+	httpClient *http.Client
+}
+
+// New returns a Client for account, authenticating with token against
+// either the live or practice (fxpractice) REST API depending on live.
+func New(account, token string, live bool) *Client {
+	return &Client{
+		account:    account,
+		token:      token,
+		live:       live,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (c *Client) baseURL() string {
+	if c.live {
+		return "https://api-fxtrade.oanda.com"
+	}
+	return "https://api-fxpractice.oanda.com"
+}
+
+// do sends an authenticated REST request, marshaling body (if non-nil) as
+// the JSON request body and unmarshaling the response into out (if
+// non-nil).
+func (c *Client) do(method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL()+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("http.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("rest: %s %s: %s: %s", method, path, resp.Status, data)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/habuka036/go-oanda-streaming-api/client"
+)
+
+// Trade mirrors OANDA's v3 trade representation as returned by
+// GetOpenTrades.
+type Trade struct {
+	Id           string `json:"id"`
+	Instrument   string `json:"instrument"`
+	Price        string `json:"price"`
+	OpenTime     string `json:"openTime"`
+	State        string `json:"state"`
+	InitialUnits string `json:"initialUnits"`
+	CurrentUnits string `json:"currentUnits"`
+	RealizedPL   string `json:"realizedPL"`
+	UnrealizedPL string `json:"unrealizedPL"`
+	Financing    string `json:"financing"`
+}
+
+// OpenTradesResponse is returned by GetOpenTrades.
+type OpenTradesResponse struct {
+	Trades            []Trade `json:"trades"`
+	LastTransactionID string  `json:"lastTransactionID"`
+}
+
+// GetOpenTrades lists the account's currently open trades.
+func (c *Client) GetOpenTrades() (*OpenTradesResponse, error) {
+	var out OpenTradesResponse
+	path := fmt.Sprintf("/v3/accounts/%s/openTrades", c.account)
+	if err := c.do(http.MethodGet, path, nil, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// ClosePositionResponse is returned by ClosePosition. Like OrderResponse,
+// the embedded transactions are client.Transaction so the resulting fills
+// can be correlated with what RunTransactions later reports.
+type ClosePositionResponse struct {
+	LongOrderCreateTransaction  *client.Transaction `json:"longOrderCreateTransaction,omitempty"`
+	LongOrderFillTransaction    *client.Transaction `json:"longOrderFillTransaction,omitempty"`
+	ShortOrderCreateTransaction *client.Transaction `json:"shortOrderCreateTransaction,omitempty"`
+	ShortOrderFillTransaction   *client.Transaction `json:"shortOrderFillTransaction,omitempty"`
+	RelatedTransactionIDs       []string            `json:"relatedTransactionIDs"`
+	LastTransactionID           string              `json:"lastTransactionID"`
+}
+
+// ClosePosition closes the entire open position (long and short) on
+// instrument.
+func (c *Client) ClosePosition(instrument string) (*ClosePositionResponse, error) {
+	body := struct {
+		LongUnits  string `json:"longUnits"`
+		ShortUnits string `json:"shortUnits"`
+	}{LongUnits: "ALL", ShortUnits: "ALL"}
+
+	var out ClosePositionResponse
+	path := fmt.Sprintf("/v3/accounts/%s/positions/%s/close", c.account, instrument)
+	if err := c.do(http.MethodPut, path, body, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}